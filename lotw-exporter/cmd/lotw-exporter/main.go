@@ -0,0 +1,154 @@
+// Command lotw-exporter exposes LoTW (and other ADIF) logbook stats as
+// Prometheus metrics, either as a long-running scrape target or as a
+// one-shot dump to stdout.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dbutler/lotw-exporter/internal/collector"
+	"github.com/dbutler/lotw-exporter/internal/qsl"
+	"github.com/dbutler/lotw-exporter/internal/qsl/clublog"
+	"github.com/dbutler/lotw-exporter/internal/qsl/eqsl"
+	"github.com/dbutler/lotw-exporter/internal/qsl/lotw"
+	"github.com/dbutler/lotw-exporter/internal/qsl/qrz"
+	"github.com/dbutler/lotw-exporter/internal/source"
+	"github.com/dbutler/lotw-exporter/internal/store"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+func main() {
+	var (
+		listenAddr  = flag.String("listen-addr", ":9726", "address to serve metrics on")
+		metricsPath = flag.String("metrics-path", "/metrics", "path to serve metrics on")
+		interval    = flag.Duration("interval", 0, "how often to refresh in server mode (default 15m)")
+		qslConfig   = flag.String("qsl-config", "", "path to a YAML config file with per-backend QSL credentials (see internal/qsl.Config); QSL_<BACKEND>_<FIELD> env vars override it, e.g. QSL_LOTW_PASSWORD")
+
+		files       = flag.String("files", "", "comma-separated ADIF files or directories to read instead of (or alongside) the confirmation services")
+		useStdin    = flag.Bool("stdin", false, "read one ADIF dump from stdin, print metrics once, and exit")
+		storePath   = flag.String("store-path", "lotw-exporter.gob", "path to the persistent state cache; empty disables persistence")
+		refreshFull = flag.Bool("refresh-full", false, "discard the persisted store and rebuild state from scratch")
+
+		mode           = flag.String("mode", "serve", `operating mode: "serve" runs a long-lived scrape server, "push-once" does a single fetch and pushes the result to a Pushgateway`)
+		pushGatewayURL = flag.String("push-gateway-url", "", "Pushgateway URL to push to in push-once mode")
+		pushJob        = flag.String("push-job", "lotw_exporter", "job name to push under in push-once mode")
+		callsign       = flag.String("callsign", "", "station callsign; used as a Pushgateway grouping key so multiple stations pushing to the same gateway don't overwrite each other")
+	)
+	flag.Parse()
+
+	if *interval == 0 {
+		*interval = 15 * time.Minute
+	}
+
+	cfg, err := qsl.LoadConfig(*qslConfig)
+	if err != nil {
+		log.Fatalf("loading qsl config: %v", err)
+	}
+
+	var sources []source.Source
+	if cfg.Backends.LoTW.Username != "" {
+		sources = append(sources, source.FromBackend(lotw.NewClient(cfg.Backends.LoTW.Username, cfg.Backends.LoTW.Password)))
+	}
+	if cfg.Backends.EQSL.Username != "" {
+		sources = append(sources, source.FromBackend(eqsl.NewClient(cfg.Backends.EQSL.Username, cfg.Backends.EQSL.Password)))
+	}
+	if cfg.Backends.QRZ.APIKey != "" {
+		sources = append(sources, source.FromBackend(qrz.NewClient(cfg.Backends.QRZ.APIKey)))
+	}
+	if cfg.Backends.ClubLog.Callsign != "" {
+		sources = append(sources, source.FromBackend(clublog.NewClient(
+			cfg.Backends.ClubLog.Callsign, cfg.Backends.ClubLog.Email,
+			cfg.Backends.ClubLog.Password, cfg.Backends.ClubLog.APIKey)))
+	}
+	for _, p := range strings.Split(*files, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			sources = append(sources, source.NewFile(p))
+		}
+	}
+
+	if *useStdin {
+		sources = append(sources, source.NewStdin())
+		runOnce(sources)
+		return
+	}
+
+	if len(sources) == 0 {
+		log.Fatal("no input source configured: set backend credentials via --qsl-config or QSL_* env vars, or pass --files or --stdin")
+	}
+
+	var st *store.Store
+	if *storePath != "" {
+		var err error
+		st, err = store.Open(*storePath)
+		if err != nil {
+			log.Fatalf("opening store: %v", err)
+		}
+	}
+
+	c := collector.NewCollector(st, sources...)
+	if *refreshFull {
+		c.RefreshFull()
+	}
+
+	switch *mode {
+	case "push-once":
+		if *pushGatewayURL == "" {
+			log.Fatal("--mode=push-once requires --push-gateway-url")
+		}
+		runPushOnce(c, *pushGatewayURL, *pushJob, *callsign)
+	case "serve":
+		prometheus.MustRegister(c)
+		c.StartBackgroundFetch(*interval)
+
+		http.Handle(*metricsPath, promhttp.Handler())
+		log.Printf("Serving metrics on %s%s", *listenAddr, *metricsPath)
+		log.Fatal(http.ListenAndServe(*listenAddr, nil))
+	default:
+		log.Fatalf("unknown --mode %q: want \"serve\" or \"push-once\"", *mode)
+	}
+}
+
+// runPushOnce performs a single synchronous fetch+aggregate pass and pushes
+// the result to a Pushgateway, for periodic cron-style invocations instead
+// of a long-running scrape server. Grouping by callsign keeps multiple
+// stations pushing to the same gateway from overwriting each other's
+// metrics.
+func runPushOnce(c *collector.Collector, gatewayURL, job, callsign string) {
+	c.FetchOnce()
+
+	pusher := push.New(gatewayURL, job).Collector(c)
+	if callsign != "" {
+		pusher = pusher.Grouping("callsign", callsign)
+	}
+	if err := pusher.Push(); err != nil {
+		log.Fatalf("pushing to gateway: %v", err)
+	}
+	log.Printf("Pushed metrics to %s (job=%s, callsign=%s)", gatewayURL, job, callsign)
+}
+
+// runOnce performs a single synchronous fetch+aggregate pass and prints the
+// resulting metrics, for use as a one-shot CLI invocation instead of a
+// scrape server.
+func runOnce(sources []source.Source) {
+	c := collector.NewCollector(nil, sources...)
+	c.FetchOnce()
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(c)
+	families, err := reg.Gather()
+	if err != nil {
+		log.Fatalf("gathering metrics: %v", err)
+	}
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			fmt.Printf("%s %v\n", f.GetName(), m)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package adif
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+const adifVersion = "3.1.4"
+
+// Header describes the ADIF file header block written before any records.
+type Header struct {
+	ProgramID      string
+	ProgramVersion string
+}
+
+// Encode writes an ADIF 3.1.4 document: a header block (if header is
+// non-nil) followed by each record in turn. Field order within a record is
+// unspecified by ADIF, so EncodeRecord emits fields in sorted name order for
+// deterministic output.
+func Encode(w io.Writer, records []Record, header *Header) error {
+	if header != nil {
+		if err := encodeHeader(w, *header); err != nil {
+			return err
+		}
+	}
+	for _, rec := range records {
+		if err := EncodeRecord(w, rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeHeader(w io.Writer, h Header) error {
+	if _, err := fmt.Fprintf(w, "<ADIF_VER:%d>%s\n", len(adifVersion), adifVersion); err != nil {
+		return err
+	}
+	if h.ProgramID != "" {
+		if _, err := fmt.Fprintf(w, "<PROGRAMID:%d>%s\n", len(h.ProgramID), h.ProgramID); err != nil {
+			return err
+		}
+	}
+	if h.ProgramVersion != "" {
+		if _, err := fmt.Fprintf(w, "<PROGRAMVERSION:%d>%s\n", len(h.ProgramVersion), h.ProgramVersion); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(w, "<EOH>\n")
+	return err
+}
+
+// EncodeRecord writes a single record as a sequence of <FIELDNAME:LEN>DATA
+// tags (or <FIELDNAME:LEN:T>DATA when rec carries a type for that field,
+// whether set explicitly via Record.Set or preserved from Parse),
+// terminated by <EOR>. LEN is the byte length of DATA, matching how Parse
+// reads it back, so a parse-then-encode round trip is byte-compatible on
+// well-formed input.
+func EncodeRecord(w io.Writer, rec Record) error {
+	names := make([]string, 0, len(rec.Values))
+	for name := range rec.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := rec.Values[name]
+		length := len(value)
+
+		var err error
+		if typ, ok := rec.Types[name]; ok {
+			_, err = fmt.Fprintf(w, "<%s:%d:%c>%s ", name, length, typ, value)
+		} else {
+			_, err = fmt.Fprintf(w, "<%s:%d>%s ", name, length, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprint(w, "<EOR>\n")
+	return err
+}
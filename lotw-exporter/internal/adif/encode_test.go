@@ -0,0 +1,57 @@
+package adif
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEncodeRecordRoundTrip(t *testing.T) {
+	var rec Record
+	rec.Set("CALL", "K1ABC", 0)
+	rec.Set("QSO_DATE", "20230101", 'D')
+	rec.Set("BAND", "20M", 0)
+
+	var buf bytes.Buffer
+	if err := EncodeRecord(&buf, rec); err != nil {
+		t.Fatalf("EncodeRecord() error = %v", err)
+	}
+
+	records, err := Parse(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatalf("Parse(encoded) error = %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+
+	got := records[0]
+	for _, name := range []string{"CALL", "QSO_DATE", "BAND"} {
+		if got.Get(name) != rec.Get(name) {
+			t.Errorf("field %s: got %q, want %q", name, got.Get(name), rec.Get(name))
+		}
+	}
+	if got.Type("QSO_DATE") != 'D' {
+		t.Errorf("expected QSO_DATE type to survive round trip, got %q", got.Type("QSO_DATE"))
+	}
+}
+
+func TestEncodeWritesHeader(t *testing.T) {
+	var buf bytes.Buffer
+	header := &Header{ProgramID: "lotw-exporter"}
+
+	if err := Encode(&buf, nil, header); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "<ADIF_VER:5>3.1.4") {
+		t.Errorf("expected ADIF_VER header, got: %s", out)
+	}
+	if !strings.Contains(out, "<PROGRAMID:13>lotw-exporter") {
+		t.Errorf("expected PROGRAMID header, got: %s", out)
+	}
+	if !strings.Contains(out, "<EOH>") {
+		t.Errorf("expected <EOH>, got: %s", out)
+	}
+}
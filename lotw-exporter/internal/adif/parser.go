@@ -8,8 +8,51 @@ import (
 	"strings"
 )
 
-// Record represents a single ADIF record (a QSO).
-type Record map[string]string
+// Record represents a single ADIF record (a QSO). Field names are always
+// upper case. Types holds the ADIF type character (e.g. 'D' for Date, 'N'
+// for Number) for fields that specified one, either because Parse saw an
+// explicit <FIELDNAME:LEN:T> tag or because the caller set one via Set; it
+// is nil, or missing an entry, for untyped fields.
+type Record struct {
+	Values map[string]string
+	Types  map[string]byte
+}
+
+// NewRecord returns an empty, ready-to-use Record.
+func NewRecord() Record {
+	return Record{Values: make(map[string]string)}
+}
+
+// Get returns the value of a field, or "" if it isn't present.
+func (r Record) Get(name string) string {
+	return r.Values[strings.ToUpper(name)]
+}
+
+// Type returns the ADIF type character recorded for a field, or 0 if none
+// was ever set.
+func (r Record) Type(name string) byte {
+	return r.Types[strings.ToUpper(name)]
+}
+
+// Set stores a field's value and, if typ is non-zero, its ADIF type
+// character. Calling Set with typ == 0 clears any previously recorded type
+// for that field without touching its value.
+func (r *Record) Set(name, value string, typ byte) {
+	if r.Values == nil {
+		r.Values = make(map[string]string)
+	}
+	name = strings.ToUpper(name)
+	r.Values[name] = value
+
+	if typ != 0 {
+		if r.Types == nil {
+			r.Types = make(map[string]byte)
+		}
+		r.Types[name] = typ
+	} else if r.Types != nil {
+		delete(r.Types, name)
+	}
+}
 
 // Parse reads ADIF data from the reader and returns a slice of Records.
 // It supports standard ADIF field formats: <FIELD_NAME:LENGTH:TYPE>DATA
@@ -69,7 +112,7 @@ func Parse(r io.Reader) ([]Record, error) {
 			// LoTW sometimes has weird data.
 			continue
 		}
-		if len(rec) > 0 {
+		if len(rec.Values) > 0 {
 			records = append(records, rec)
 		}
 	}
@@ -82,7 +125,7 @@ func Parse(r io.Reader) ([]Record, error) {
 }
 
 func parseRecord(text string) (Record, error) {
-	rec := make(Record)
+	rec := NewRecord()
 	// Iterate looking for <
 
 	// A robust parser would march through the string.
@@ -126,8 +169,16 @@ func parseRecord(text string) (Record, error) {
 			continue
 		}
 
+		var typ byte
+		if len(parts) >= 3 && len(parts[2]) == 1 {
+			typ = parts[2][0]
+		}
+
 		// Data starts after >
 		dataStart := end + 1
+		// length is a byte count per the ADIF spec, not a rune count: Go
+		// string indices are already byte offsets, so this slices correctly
+		// even for multi-byte UTF-8 field data.
 		dataEnd := dataStart + length
 
 		if dataEnd > len(input) {
@@ -135,7 +186,7 @@ func parseRecord(text string) (Record, error) {
 		}
 
 		value := input[dataStart:dataEnd]
-		rec[fieldName] = value
+		rec.Set(fieldName, value, typ)
 
 		input = input[dataEnd:]
 	}
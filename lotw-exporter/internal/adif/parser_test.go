@@ -20,13 +20,30 @@ func TestParse(t *testing.T) {
 		t.Errorf("Expected 2 records, got %d", len(records))
 	}
 
-	if records[0]["CALL"] != "K1ABC" {
-		t.Errorf("Record 1 CALL mismatch: %v", records[0]["CALL"])
+	if records[0].Get("CALL") != "K1ABC" {
+		t.Errorf("Record 1 CALL mismatch: %v", records[0].Get("CALL"))
 	}
-	if records[1]["MODE"] != "SSB" {
-		t.Errorf("Record 2 MODE mismatch: %v", records[1]["MODE"])
+	if records[1].Get("MODE") != "SSB" {
+		t.Errorf("Record 2 MODE mismatch: %v", records[1].Get("MODE"))
 	}
-	if records[1]["QSL_RCVD"] != "Y" {
-		t.Errorf("Record 2 QSL_RCVD mismatch: %v", records[1]["QSL_RCVD"])
+	if records[1].Get("QSL_RCVD") != "Y" {
+		t.Errorf("Record 2 QSL_RCVD mismatch: %v", records[1].Get("QSL_RCVD"))
+	}
+}
+
+func TestParsePreservesFieldType(t *testing.T) {
+	r := strings.NewReader(`<call:5>K1ABC <qso_date:8:D>20230101 <eor>`)
+	records, err := Parse(r)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if typ := records[0].Type("QSO_DATE"); typ != 'D' {
+		t.Errorf("expected QSO_DATE type 'D', got %q", typ)
+	}
+	if typ := records[0].Type("CALL"); typ != 0 {
+		t.Errorf("expected CALL to have no type, got %q", typ)
 	}
 }
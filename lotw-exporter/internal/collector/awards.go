@@ -0,0 +1,164 @@
+package collector
+
+import (
+	"strings"
+
+	"github.com/dbutler/lotw-exporter/internal/adif"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// awardMetrics tracks amateur radio award/contest progress: DXCC (worked
+// and confirmed, including DXCC Challenge entity×band slots), Worked All
+// States, Worked All Continents, VUCC grid squares, and CQ/ITU zones. These
+// are computed fresh from the full record set on every fetch, since award
+// progress depends on set membership (unique entities, states, zones) which
+// can't be derived incrementally.
+type awardMetrics struct {
+	dxccWorked    *prometheus.GaugeVec // band, mode
+	dxccConfirmed *prometheus.GaugeVec // band, mode -- DXCC Challenge slots
+	wasConfirmed  *prometheus.GaugeVec // band, mode
+	wacContinents prometheus.Gauge
+	gridSquares   *prometheus.GaugeVec // band -- VUCC
+	cqZones       prometheus.Gauge
+	ituZones      prometheus.Gauge
+}
+
+func newAwardMetrics() awardMetrics {
+	return awardMetrics{
+		dxccWorked: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lotw_dxcc_worked",
+			Help: "Number of unique DXCC entities worked",
+		}, []string{"band", "mode"}),
+		dxccConfirmed: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lotw_dxcc_confirmed",
+			Help: "Number of unique DXCC entity/band slots confirmed (DXCC Challenge)",
+		}, []string{"band", "mode"}),
+		wasConfirmed: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lotw_was_confirmed_states",
+			Help: "Number of unique US states confirmed (Worked All States)",
+		}, []string{"band", "mode"}),
+		wacContinents: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "lotw_wac_continents_confirmed",
+			Help: "Number of unique continents confirmed (Worked All Continents)",
+		}),
+		gridSquares: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lotw_grid_squares_confirmed",
+			Help: "Number of unique 4-character grid squares confirmed (VUCC)",
+		}, []string{"band"}),
+		cqZones: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "lotw_cq_zones_confirmed",
+			Help: "Number of unique CQ zones confirmed",
+		}),
+		ituZones: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "lotw_itu_zones_confirmed",
+			Help: "Number of unique ITU zones confirmed",
+		}),
+	}
+}
+
+func (m awardMetrics) describe(ch chan<- *prometheus.Desc) {
+	m.dxccWorked.Describe(ch)
+	m.dxccConfirmed.Describe(ch)
+	m.wasConfirmed.Describe(ch)
+	m.wacContinents.Describe(ch)
+	m.gridSquares.Describe(ch)
+	m.cqZones.Describe(ch)
+	m.ituZones.Describe(ch)
+}
+
+func (m awardMetrics) collect(ch chan<- prometheus.Metric) {
+	m.dxccWorked.Collect(ch)
+	m.dxccConfirmed.Collect(ch)
+	m.wasConfirmed.Collect(ch)
+	m.wacContinents.Collect(ch)
+	m.gridSquares.Collect(ch)
+	m.cqZones.Collect(ch)
+	m.ituZones.Collect(ch)
+}
+
+// bandMode labels the per-band, per-mode award gauges.
+type bandMode struct{ band, mode string }
+
+// usDXCCEntity is the ADIF DXCC entity number for the United States. STATE
+// is reused by several other entities (Canadian provinces, Russian
+// oblasts, Japanese prefectures, Australian states, ...), so WAS progress
+// must only count STATE values from QSOs actually worked in the US.
+const usDXCCEntity = "291"
+
+// update recomputes every award gauge from the full set of records known
+// for this fetch (i.e. the store's contents when persistence is enabled,
+// or just this fetch's records otherwise).
+func (m awardMetrics) update(records []adif.Record) {
+	dxccWorked := make(map[bandMode]map[string]bool)
+	dxccConfirmed := make(map[bandMode]map[string]bool)
+	was := make(map[bandMode]map[string]bool)
+	continents := make(map[string]bool)
+	grids := make(map[string]map[string]bool) // band -> 4-char grid squares
+	cqZones := make(map[string]bool)
+	ituZones := make(map[string]bool)
+
+	for _, rec := range records {
+		band := NormalizeBand(rec.Get("BAND"))
+		mode := strings.ToUpper(rec.Get("MODE"))
+		bm := bandMode{band, mode}
+		confirmed := strings.ToUpper(rec.Get("QSL_RCVD")) == "Y"
+
+		if dxcc := rec.Get("DXCC"); dxcc != "" {
+			addToSet(dxccWorked, bm, dxcc)
+			if confirmed {
+				addToSet(dxccConfirmed, bm, dxcc)
+			}
+		}
+
+		if !confirmed {
+			continue
+		}
+
+		if state := rec.Get("STATE"); state != "" && rec.Get("DXCC") == usDXCCEntity {
+			addToSet(was, bm, state)
+		}
+		if cont := rec.Get("CONT"); cont != "" {
+			continents[strings.ToUpper(cont)] = true
+		}
+		if grid := rec.Get("GRIDSQUARE"); len(grid) >= 4 {
+			if grids[band] == nil {
+				grids[band] = make(map[string]bool)
+			}
+			grids[band][strings.ToUpper(grid[:4])] = true
+		}
+		if cqz := rec.Get("CQZ"); cqz != "" {
+			cqZones[cqz] = true
+		}
+		if ituz := rec.Get("ITUZ"); ituz != "" {
+			ituZones[ituz] = true
+		}
+	}
+
+	m.dxccWorked.Reset()
+	for bm, set := range dxccWorked {
+		m.dxccWorked.WithLabelValues(bm.band, bm.mode).Set(float64(len(set)))
+	}
+	m.dxccConfirmed.Reset()
+	for bm, set := range dxccConfirmed {
+		m.dxccConfirmed.WithLabelValues(bm.band, bm.mode).Set(float64(len(set)))
+	}
+	m.wasConfirmed.Reset()
+	for bm, set := range was {
+		m.wasConfirmed.WithLabelValues(bm.band, bm.mode).Set(float64(len(set)))
+	}
+	m.gridSquares.Reset()
+	for band, set := range grids {
+		m.gridSquares.WithLabelValues(band).Set(float64(len(set)))
+	}
+
+	m.wacContinents.Set(float64(len(continents)))
+	m.cqZones.Set(float64(len(cqZones)))
+	m.ituZones.Set(float64(len(ituZones)))
+}
+
+func addToSet(sets map[bandMode]map[string]bool, bm bandMode, value string) {
+	if sets[bm] == nil {
+		sets[bm] = make(map[string]bool)
+	}
+	sets[bm][value] = true
+}
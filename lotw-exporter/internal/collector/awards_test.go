@@ -0,0 +1,34 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/dbutler/lotw-exporter/internal/adif"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func newRecord(fields map[string]string) adif.Record {
+	rec := adif.NewRecord()
+	for name, value := range fields {
+		rec.Set(name, value, 0)
+	}
+	return rec
+}
+
+func TestUpdateOnlyCountsUSStatesTowardWAS(t *testing.T) {
+	m := newAwardMetrics()
+	m.update([]adif.Record{
+		newRecord(map[string]string{
+			"BAND": "20M", "MODE": "FT8", "DXCC": usDXCCEntity, "STATE": "CA", "QSL_RCVD": "Y",
+		}),
+		// Ontario, Canada (DXCC 1) also sets STATE but must not count as WAS.
+		newRecord(map[string]string{
+			"BAND": "20M", "MODE": "FT8", "DXCC": "1", "STATE": "ON", "QSL_RCVD": "Y",
+		}),
+	})
+
+	got := testutil.ToFloat64(m.wasConfirmed.WithLabelValues("20M", "FT8"))
+	if got != 1 {
+		t.Errorf("wasConfirmed = %v, want 1 (only the US state should count)", got)
+	}
+}
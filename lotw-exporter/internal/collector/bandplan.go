@@ -0,0 +1,79 @@
+package collector
+
+import (
+	"strconv"
+	"strings"
+)
+
+// bandRange is one amateur allocation in the band plan, used to resolve a
+// bare frequency to its canonical band name.
+type bandRange struct {
+	name            string
+	lowMHz, highMHz float64
+}
+
+// bandPlan lists the HF/VHF/UHF amateur allocations we expect to see in
+// LoTW/ADIF data, used by NormalizeBand to resolve frequencies to bands.
+// It isn't exhaustive of every sub-band worldwide, just enough to bucket
+// the BAND values exporters in the wild actually emit.
+var bandPlan = []bandRange{
+	{"2190M", 0.1357, 0.1378},
+	{"630M", 0.472, 0.479},
+	{"160M", 1.8, 2.0},
+	{"80M", 3.5, 4.0},
+	{"60M", 5.06, 5.45},
+	{"40M", 7.0, 7.3},
+	{"30M", 10.1, 10.15},
+	{"20M", 14.0, 14.35},
+	{"17M", 18.068, 18.168},
+	{"15M", 21.0, 21.45},
+	{"12M", 24.89, 24.99},
+	{"10M", 28.0, 29.7},
+	{"6M", 50.0, 54.0},
+	{"4M", 70.0, 70.5},
+	{"2M", 144.0, 148.0},
+	{"1.25M", 222.0, 225.0},
+	{"70CM", 420.0, 450.0},
+	{"33CM", 902.0, 928.0},
+	{"23CM", 1240.0, 1300.0},
+}
+
+// NormalizeBand resolves a free-form ADIF BAND value to its canonical,
+// upper-case bucket. It accepts values already in band form ("20M", "20m"),
+// and bare frequencies with an optional MHz/kHz suffix ("14MHz", "14074kHz",
+// "14.074"), which are resolved against the band plan. Values that can't be
+// recognized are returned upper-cased and otherwise unchanged.
+func NormalizeBand(raw string) string {
+	s := strings.ToUpper(strings.TrimSpace(raw))
+	if s == "" {
+		return ""
+	}
+
+	// Already a band name: ADIF band values always end in M or CM.
+	if strings.HasSuffix(s, "CM") || strings.HasSuffix(s, "M") {
+		return s
+	}
+
+	if freq, ok := strings.CutSuffix(s, "KHZ"); ok {
+		if v, err := strconv.ParseFloat(freq, 64); err == nil {
+			return bandForFrequency(v / 1000)
+		}
+		return s
+	}
+
+	freq := strings.TrimSuffix(s, "MHZ")
+	if v, err := strconv.ParseFloat(freq, 64); err == nil {
+		return bandForFrequency(v)
+	}
+
+	return s
+}
+
+func bandForFrequency(mhz float64) string {
+	for _, b := range bandPlan {
+		if mhz >= b.lowMHz && mhz <= b.highMHz {
+			return b.name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,21 @@
+package collector
+
+import "testing"
+
+func TestNormalizeBand(t *testing.T) {
+	cases := map[string]string{
+		"20M":     "20M",
+		"20m":     "20M",
+		"14MHz":   "20M",
+		"14.074":  "20M",
+		"7000kHz": "40M",
+		"70cm":    "70CM",
+		"":        "",
+	}
+
+	for in, want := range cases {
+		if got := NormalizeBand(in); got != want {
+			t.Errorf("NormalizeBand(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
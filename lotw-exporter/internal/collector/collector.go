@@ -1,6 +1,7 @@
 package collector
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -9,12 +10,19 @@ import (
 	"time"
 
 	"github.com/dbutler/lotw-exporter/internal/adif"
-	"github.com/dbutler/lotw-exporter/internal/lotw"
+	"github.com/dbutler/lotw-exporter/internal/source"
+	"github.com/dbutler/lotw-exporter/internal/store"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// fetchOverlap is subtracted from the store's last-fetch timestamp before
+// each incremental fetch, so a QSO logged just before the previous fetch
+// ran isn't missed due to clock skew or processing delay at the source.
+const fetchOverlap = 24 * time.Hour
+
 type Collector struct {
-	client *lotw.Client
+	sources []source.Source
+	store   *store.Store
 
 	// Metrics
 	qsoTotal       *prometheus.GaugeVec
@@ -27,20 +35,31 @@ type Collector struct {
 	// Daily History
 	qsoHistory *prometheus.GaugeVec // labels: date, band
 
+	// Award progress (DXCC, WAS, WAC, VUCC, zones)
+	awards awardMetrics
+
 	mu sync.Mutex
 }
 
-func NewCollector(client *lotw.Client) *Collector {
+// NewCollector builds a Collector that fetches and merges ADIF records from
+// the given sources. A single source (e.g. just LoTW) is the common case;
+// passing several (LoTW plus a local file export, say) lets records from
+// each be reconciled into one view.
+//
+// st may be nil, in which case the collector always fetches and rebuilds
+// its full state from scratch rather than persisting between runs.
+func NewCollector(st *store.Store, sources ...source.Source) *Collector {
 	return &Collector{
-		client: client,
+		sources: sources,
+		store:   st,
 		qsoTotal: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "lotw_qso_total",
 			Help: "Total number of QSOs logged in LoTW",
 		}, []string{"band", "mode"}),
 		qslTotal: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "lotw_qsl_confirmed_total",
-			Help: "Total number of confirmed QSLs",
-		}, []string{"band", "mode"}),
+			Help: "Total number of confirmed QSLs, by confirmation source",
+		}, []string{"source", "band", "mode"}),
 		dxccCount: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "lotw_dxcc_entities_count",
 			Help: "Number of unique DXCC entities confirmed",
@@ -61,6 +80,7 @@ func NewCollector(client *lotw.Client) *Collector {
 			Name: "lotw_qso_history_count",
 			Help: "Number of QSOs per day for the recent past",
 		}, []string{"date", "band"}),
+		awards: newAwardMetrics(),
 	}
 }
 
@@ -73,6 +93,7 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.scrapeSuccess.Describe(ch)
 	c.lastFetch.Describe(ch)
 	c.qsoHistory.Describe(ch)
+	c.awards.describe(ch)
 }
 
 // Collect implements prometheus.Collector
@@ -90,6 +111,7 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.scrapeSuccess.Collect(ch)
 	c.lastFetch.Collect(ch)
 	c.qsoHistory.Collect(ch)
+	c.awards.collect(ch)
 }
 
 // StartBackgroundFetch runs the fetch loop.
@@ -104,48 +126,51 @@ func (c *Collector) StartBackgroundFetch(interval time.Duration) {
 	}()
 }
 
+// FetchOnce performs a single synchronous fetch+aggregate pass, for
+// one-shot CLI invocations that don't want a background ticker.
+func (c *Collector) FetchOnce() {
+	c.fetch()
+}
+
+// RefreshFull discards any persisted store state, so the next fetch
+// rebuilds everything from scratch instead of fetching incrementally.
+func (c *Collector) RefreshFull() {
+	if c.store != nil {
+		c.store.Reset()
+	}
+}
+
 func (c *Collector) fetch() {
 	start := time.Now()
-	log.Println("Starting LoTW fetch...")
+	log.Println("Starting fetch...")
+
+	since := time.Time{}
+	if c.store != nil {
+		if last := c.store.LastFetch(); !last.IsZero() {
+			since = last.Add(-fetchOverlap)
+		}
+	}
 
-	// Fetch all records (since zero time) to rebuild full state.
-	// Optimization: could cache and only fetch delta, but for simplicity we fetch all.
-	// Users might have thousands of records; LoTW download is reasonably fast for text.
-	r, err := c.client.FetchReport(time.Time{})
+	fetched, err := c.fetchAndMerge(context.Background(), since)
 
 	duration := time.Since(start).Seconds()
 
 	c.mu.Lock()
 	c.scrapeDuration.Set(duration)
 	if err != nil {
-		log.Printf("Error fetching LoTW report: %v", err)
+		log.Printf("Error fetching records: %v", err)
 		c.scrapeSuccess.Set(0)
 		c.mu.Unlock()
 		return
 	}
-	defer r.Close()
 
-	// Read entire body to debug size and content
-	bodyBytes, err := io.ReadAll(r)
-	if err != nil {
-		log.Printf("Error reading LoTW response: %v", err)
-		c.scrapeSuccess.Set(0)
-		c.mu.Unlock()
-		return
-	}
-	r.Close()
-
-	log.Printf("Downloaded %d bytes from LoTW", len(bodyBytes))
-
-	// Create reader from bytes
-	byteReader := strings.NewReader(string(bodyBytes))
-
-	records, err := adif.Parse(byteReader)
-	if err != nil {
-		log.Printf("Error parsing ADIF: %v", err)
-		c.scrapeSuccess.Set(0)
-		c.mu.Unlock()
-		return
+	records := fetched
+	if c.store != nil {
+		c.store.Merge(fetched, time.Now())
+		if err := c.store.Save(); err != nil {
+			log.Printf("Error saving store: %v", err)
+		}
+		records = c.store.Records()
 	}
 
 	c.scrapeSuccess.Set(1)
@@ -165,8 +190,8 @@ func (c *Collector) fetch() {
 	dateCounts := make(map[string]float64)
 
 	for _, rec := range records {
-		band := rec["BAND"]
-		mode := rec["MODE"]
+		band := rec.Get("BAND")
+		mode := rec.Get("MODE")
 
 		// Total QSOs
 		c.qsoTotal.WithLabelValues(band, mode).Inc()
@@ -176,11 +201,12 @@ func (c *Collector) fetch() {
 		// Standard ADIF for LoTW usually puts confirmation in QSL_RCVD if fetched from LoTW.
 		// Let's check both QSL_RCVD and APP_LOTW_QSL_RCVD if present?
 		// We'll stick to QSL_RCVD being 'Y'.
-		qslRcvd := strings.ToUpper(rec["QSL_RCVD"]) // Standard
+		qslRcvd := strings.ToUpper(rec.Get("QSL_RCVD")) // Standard
 		if qslRcvd == "Y" {
-			c.qslTotal.WithLabelValues(band, mode).Inc()
+			src := rec.Get("APP_EXPORTER_SOURCE")
+			c.qslTotal.WithLabelValues(src, band, mode).Inc()
 
-			if dxcc, ok := rec["DXCC"]; ok && dxcc != "" {
+			if dxcc := rec.Get("DXCC"); dxcc != "" {
 				dxccConfirmed[dxcc] = true
 			}
 		}
@@ -189,10 +215,10 @@ func (c *Collector) fetch() {
 		// Use APP_LOTW_QSO_TIMESTAMP if available (ISO8601: 2025-12-10T15:31:30Z)
 		// Fallback to QSO_DATE (YYYYMMDD)
 		var d string
-		if ts, ok := rec["APP_LOTW_QSO_TIMESTAMP"]; ok && len(ts) >= 10 {
+		if ts := rec.Get("APP_LOTW_QSO_TIMESTAMP"); len(ts) >= 10 {
 			d = ts[0:10] // YYYY-MM-DD
 		} else {
-			qsoDateRaw := rec["QSO_DATE"]
+			qsoDateRaw := rec.Get("QSO_DATE")
 			if len(qsoDateRaw) == 8 {
 				d = fmt.Sprintf("%s-%s-%s", qsoDateRaw[0:4], qsoDateRaw[4:6], qsoDateRaw[6:8])
 			}
@@ -217,6 +243,86 @@ func (c *Collector) fetch() {
 		}
 	}
 
+	c.awards.update(records)
+
 	c.mu.Unlock()
-	log.Printf("LoTW fetch complete. Processed %d records.", len(records))
+	log.Printf("Fetch complete. Processed %d records.", len(records))
+}
+
+// fetchAndMerge pulls records reported since the given time from every
+// configured source and merges them into a single deduplicated slice, keyed
+// by source.RecordKey. Each record is tagged with APP_EXPORTER_SOURCE
+// (the name of the source that first reported it) before dedup, so
+// per-source metrics like qslTotal can still be broken out by confirmation
+// service after merging. BAND is normalized through NormalizeBand at the
+// same point, so every downstream gauge (qsoTotal, qslTotal, qsoHistory,
+// the award gauges) and source.RecordKey itself see the same canonical
+// band label regardless of which source's free-form BAND value produced
+// it. When two sources report the same QSO, fields present in the later
+// source fill in any gaps left by the earlier one (e.g. a file export
+// confirming a QSL a LoTW fetch hasn't caught up on yet), but the original
+// APP_EXPORTER_SOURCE is left untouched. The result is only the delta
+// since `since`; callers that persist state are responsible for merging it
+// into the full history themselves.
+func (c *Collector) fetchAndMerge(ctx context.Context, since time.Time) ([]adif.Record, error) {
+	merged := make(map[string]adif.Record)
+	var order []string
+
+	for _, src := range c.sources {
+		r, err := src.Fetch(ctx, since)
+		if err != nil {
+			return nil, fmt.Errorf("fetching from %s: %w", src.Name(), err)
+		}
+
+		bodyBytes, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading from %s: %w", src.Name(), err)
+		}
+		log.Printf("Downloaded %d bytes from %s", len(bodyBytes), src.Name())
+
+		records, err := adif.Parse(strings.NewReader(string(bodyBytes)))
+		if err != nil {
+			return nil, fmt.Errorf("parsing adif from %s: %w", src.Name(), err)
+		}
+
+		for _, rec := range records {
+			rec.Set("APP_EXPORTER_SOURCE", src.Name(), 0)
+			rec.Set("BAND", NormalizeBand(rec.Get("BAND")), 0)
+			key := source.RecordKey(rec)
+			if existing, ok := merged[key]; ok {
+				mergeInto(&existing, rec)
+				merged[key] = existing
+				continue
+			}
+			merged[key] = rec
+			order = append(order, key)
+		}
+	}
+
+	out := make([]adif.Record, 0, len(order))
+	for _, key := range order {
+		out = append(out, merged[key])
+	}
+	return out, nil
+}
+
+// mergeInto copies fields from src into dst wherever dst doesn't already
+// have a value, so a later source can fill in gaps (e.g. a QSL
+// confirmation) without clobbering fields an earlier source already set.
+// QSL_RCVD is special-cased to let a later source's "Y" win over an
+// earlier "N": a QSO unconfirmed at one service is still confirmed overall
+// if another service reports it as QSLed, and APP_EXPORTER_SOURCE is
+// updated alongside it so per-source metrics credit the service that
+// actually confirmed it.
+func mergeInto(dst *adif.Record, src adif.Record) {
+	for name, value := range src.Values {
+		switch {
+		case dst.Values[name] == "":
+			dst.Set(name, value, src.Types[name])
+		case name == "QSL_RCVD" && strings.ToUpper(value) == "Y" && strings.ToUpper(dst.Values[name]) != "Y":
+			dst.Set(name, value, src.Types[name])
+			dst.Set("APP_EXPORTER_SOURCE", src.Values["APP_EXPORTER_SOURCE"], 0)
+		}
+	}
 }
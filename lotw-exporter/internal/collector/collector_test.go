@@ -0,0 +1,80 @@
+package collector
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSource is a minimal source.Source for tests that don't need a real
+// file or network backend.
+type fakeSource struct {
+	name string
+	adif string
+}
+
+func (f fakeSource) Name() string { return f.name }
+
+func (f fakeSource) Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(f.adif)), nil
+}
+
+func TestMergeIntoLetsLaterConfirmationWin(t *testing.T) {
+	dst := newRecord(map[string]string{
+		"CALL": "K1ABC", "QSO_DATE": "20230101", "TIME_ON": "1200",
+		"BAND": "20M", "MODE": "FT8", "QSL_RCVD": "N",
+		"APP_EXPORTER_SOURCE": "lotw",
+	})
+	src := newRecord(map[string]string{
+		"CALL": "K1ABC", "QSO_DATE": "20230101", "TIME_ON": "1200",
+		"BAND": "20M", "MODE": "FT8", "QSL_RCVD": "Y",
+		"APP_EXPORTER_SOURCE": "eqsl",
+	})
+
+	mergeInto(&dst, src)
+
+	if got := dst.Get("QSL_RCVD"); got != "Y" {
+		t.Errorf("QSL_RCVD = %q, want %q (later confirmation should win)", got, "Y")
+	}
+	if got := dst.Get("APP_EXPORTER_SOURCE"); got != "eqsl" {
+		t.Errorf("APP_EXPORTER_SOURCE = %q, want %q (should credit the confirming source)", got, "eqsl")
+	}
+}
+
+func TestMergeIntoDoesNotRegressExistingConfirmation(t *testing.T) {
+	dst := newRecord(map[string]string{
+		"QSL_RCVD": "Y", "APP_EXPORTER_SOURCE": "lotw",
+	})
+	src := newRecord(map[string]string{
+		"QSL_RCVD": "N", "APP_EXPORTER_SOURCE": "eqsl",
+	})
+
+	mergeInto(&dst, src)
+
+	if got := dst.Get("QSL_RCVD"); got != "Y" {
+		t.Errorf("QSL_RCVD = %q, want %q (existing confirmation must not be overwritten)", got, "Y")
+	}
+	if got := dst.Get("APP_EXPORTER_SOURCE"); got != "lotw" {
+		t.Errorf("APP_EXPORTER_SOURCE = %q, want %q", got, "lotw")
+	}
+}
+
+func TestFetchAndMergeNormalizesBand(t *testing.T) {
+	c := NewCollector(nil, fakeSource{
+		name: "file",
+		adif: `<call:5>K1ABC <qso_date:8>20230101 <band:8>14074kHz <mode:3>FT8 <eor>`,
+	})
+
+	records, err := c.fetchAndMerge(context.Background(), time.Time{})
+	if err != nil {
+		t.Fatalf("fetchAndMerge() error = %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if got := records[0].Get("BAND"); got != "20M" {
+		t.Errorf("BAND = %q, want %q (raw frequency should resolve through NormalizeBand)", got, "20M")
+	}
+}
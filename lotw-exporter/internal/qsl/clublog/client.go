@@ -0,0 +1,72 @@
+// Package clublog implements the qsl.Backend interface for ClubLog's ADIF
+// export API.
+package clublog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Client handles interaction with ClubLog.
+type Client struct {
+	Callsign   string
+	Email      string
+	Password   string
+	APIKey     string
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new ClubLog client.
+func NewClient(callsign, email, password, apiKey string) *Client {
+	return &Client{
+		Callsign:   callsign,
+		Email:      email,
+		Password:   password,
+		APIKey:     apiKey,
+		BaseURL:    "https://clublog.org/getadif.php",
+		HTTPClient: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// Name identifies this backend as "clublog" for confirmation metric labels.
+func (c *Client) Name() string { return "clublog" }
+
+// Fetch downloads the ADIF export for this callsign's ClubLog account.
+// since, if non-zero, is passed through as a startyear/startmonth/startday
+// filter.
+func (c *Client) Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error) {
+	v := url.Values{}
+	v.Set("call", c.Callsign)
+	v.Set("email", c.Email)
+	v.Set("password", c.Password)
+	v.Set("api", c.APIKey)
+	if !since.IsZero() {
+		v.Set("startyear", since.Format("2006"))
+		v.Set("startmonth", since.Format("1"))
+		v.Set("startday", since.Format("2"))
+	}
+
+	reqURL := fmt.Sprintf("%s?%s", c.BaseURL, v.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("performing request: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("clublog api returned status: %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
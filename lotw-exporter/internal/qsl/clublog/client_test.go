@@ -0,0 +1,36 @@
+package clublog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetchSendsCredentialsAndDateFilter(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		io.WriteString(w, `<call:5>K1ABC <eor>`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("K1ABC", "k1abc@example.com", "pass", "apikey")
+	c.BaseURL = srv.URL
+
+	since := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	body, err := c.Fetch(context.Background(), since)
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer body.Close()
+
+	for _, want := range []string{"call=K1ABC", "api=apikey", "startyear=2026", "startmonth=3", "startday=4"} {
+		if !strings.Contains(gotQuery, want) {
+			t.Errorf("query %q missing %q", gotQuery, want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package qsl
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds credentials for every supported QSL confirmation backend,
+// loaded from a YAML file with environment-variable overrides layered on
+// top (QSL_<BACKEND>_<FIELD>, e.g. QSL_LOTW_PASSWORD), so secrets can be
+// kept out of the config file on disk and out of the process's command
+// line / shell history.
+type Config struct {
+	Backends struct {
+		LoTW    BackendConfig `yaml:"lotw"`
+		EQSL    BackendConfig `yaml:"eqsl"`
+		QRZ     BackendConfig `yaml:"qrz"`
+		ClubLog BackendConfig `yaml:"clublog"`
+	} `yaml:"backends"`
+}
+
+// BackendConfig holds the credential fields a backend might need; each
+// backend's constructor only reads the fields relevant to it.
+type BackendConfig struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Callsign string `yaml:"callsign"`
+	Email    string `yaml:"email"`
+	APIKey   string `yaml:"api_key"`
+}
+
+// LoadConfig reads a YAML config file at path, then applies
+// QSL_<BACKEND>_<FIELD> environment variable overrides on top of whatever
+// it finds. path may be empty, in which case the returned Config is built
+// from environment variables alone.
+func LoadConfig(path string) (*Config, error) {
+	cfg := &Config{}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading qsl config: %w", err)
+		}
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("parsing qsl config: %w", err)
+		}
+	}
+
+	applyEnvOverrides(&cfg.Backends.LoTW, "LOTW")
+	applyEnvOverrides(&cfg.Backends.EQSL, "EQSL")
+	applyEnvOverrides(&cfg.Backends.QRZ, "QRZ")
+	applyEnvOverrides(&cfg.Backends.ClubLog, "CLUBLOG")
+
+	return cfg, nil
+}
+
+// applyEnvOverrides overlays QSL_<prefix>_<FIELD> environment variables
+// onto bc, e.g. QSL_LOTW_PASSWORD overrides Backends.LoTW.Password.
+func applyEnvOverrides(bc *BackendConfig, prefix string) {
+	override := func(field *string, name string) {
+		if v := os.Getenv(fmt.Sprintf("QSL_%s_%s", prefix, name)); v != "" {
+			*field = v
+		}
+	}
+	override(&bc.Username, "USERNAME")
+	override(&bc.Password, "PASSWORD")
+	override(&bc.Callsign, "CALLSIGN")
+	override(&bc.Email, "EMAIL")
+	override(&bc.APIKey, "API_KEY")
+}
@@ -0,0 +1,47 @@
+package qsl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfigAppliesEnvOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "qsl.yaml")
+	if err := os.WriteFile(path, []byte(`
+backends:
+  lotw:
+    username: K1ABC
+    password: fromfile
+`), 0o644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	t.Setenv("QSL_LOTW_PASSWORD", "fromenv")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	if cfg.Backends.LoTW.Username != "K1ABC" {
+		t.Errorf("Username = %q, want %q (from file)", cfg.Backends.LoTW.Username, "K1ABC")
+	}
+	if cfg.Backends.LoTW.Password != "fromenv" {
+		t.Errorf("Password = %q, want %q (env should override file)", cfg.Backends.LoTW.Password, "fromenv")
+	}
+}
+
+func TestLoadConfigWithoutPathUsesEnvOnly(t *testing.T) {
+	t.Setenv("QSL_EQSL_USERNAME", "K1ABC")
+	t.Setenv("QSL_EQSL_PASSWORD", "secret")
+
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	if cfg.Backends.EQSL.Username != "K1ABC" || cfg.Backends.EQSL.Password != "secret" {
+		t.Errorf("Backends.EQSL = %+v, want Username=K1ABC Password=secret", cfg.Backends.EQSL)
+	}
+}
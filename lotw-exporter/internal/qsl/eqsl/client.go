@@ -0,0 +1,89 @@
+// Package eqsl implements the qsl.Backend interface for eQSL.cc's Inbox
+// download API.
+package eqsl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/dbutler/lotw-exporter/internal/adif"
+)
+
+// Client handles interaction with eQSL.cc.
+type Client struct {
+	Username    string
+	Password    string
+	QTHNickname string // optional, only needed for accounts with multiple QTHs
+	BaseURL     string
+	HTTPClient  *http.Client
+}
+
+// NewClient creates a new eQSL client.
+func NewClient(username, password string) *Client {
+	return &Client{
+		Username:   username,
+		Password:   password,
+		BaseURL:    "https://www.eqsl.cc/qslcard/DownloadInBox.cfm",
+		HTTPClient: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// Name identifies this backend as "eqsl" for confirmation metric labels.
+func (c *Client) Name() string { return "eqsl" }
+
+// Fetch downloads confirmed QSOs from the eQSL inbox as ADIF. The
+// ConfirmedOnly query param restricts the inbox to QSLed cards, but eQSL's
+// export doesn't reliably tag each record with QSL_RCVD itself, so every
+// record is force-set to QSL_RCVD=Y before being returned -- otherwise a
+// record missing that field would silently vanish from confirmation
+// metrics. since, if non-zero, is passed through as RcvdSince.
+func (c *Client) Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error) {
+	v := url.Values{}
+	v.Set("UserName", c.Username)
+	v.Set("Password", c.Password)
+	if c.QTHNickname != "" {
+		v.Set("QTHNickname", c.QTHNickname)
+	}
+	v.Set("ConfirmedOnly", "1")
+	if !since.IsZero() {
+		v.Set("RcvdSince", since.Format("01/02/2006"))
+	}
+
+	reqURL := fmt.Sprintf("%s?%s", c.BaseURL, v.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("performing request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("eqsl api returned status: %d", resp.StatusCode)
+	}
+
+	records, err := adif.Parse(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("parsing eqsl adif response: %w", err)
+	}
+	for i := range records {
+		records[i].Set("QSL_RCVD", "Y", 0)
+	}
+
+	var buf bytes.Buffer
+	if err := adif.Encode(&buf, records, nil); err != nil {
+		return nil, fmt.Errorf("re-encoding eqsl records as adif: %w", err)
+	}
+
+	return io.NopCloser(&buf), nil
+}
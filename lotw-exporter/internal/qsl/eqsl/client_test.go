@@ -0,0 +1,44 @@
+package eqsl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dbutler/lotw-exporter/internal/adif"
+)
+
+func TestFetchForceSetsQSLRcvd(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// eQSL's inbox export doesn't necessarily tag QSL_RCVD itself.
+		io.WriteString(w, `<call:5>K1ABC <qso_date:8>20230101 <band:3>20M <mode:2>CW <eor>`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("user", "pass")
+	c.BaseURL = srv.URL
+
+	body, err := c.Fetch(context.Background(), time.Time{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer body.Close()
+
+	records, err := adif.Parse(body)
+	if err != nil {
+		t.Fatalf("parsing fetch result: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if got := records[0].Get("QSL_RCVD"); got != "Y" {
+		t.Errorf("QSL_RCVD = %q, want %q", got, "Y")
+	}
+	if !strings.EqualFold(records[0].Get("CALL"), "K1ABC") {
+		t.Errorf("CALL = %q, want K1ABC", records[0].Get("CALL"))
+	}
+}
@@ -1,6 +1,9 @@
+// Package lotw implements the qsl.Backend interface for ARRL's Logbook of
+// The World.
 package lotw
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -28,10 +31,13 @@ func NewClient(username, password string) *Client {
 	}
 }
 
-// FetchReport downloads the ADIF report from LoTW.
+// Name identifies this backend as "lotw" for confirmation metric labels.
+func (c *Client) Name() string { return "lotw" }
+
+// Fetch downloads the ADIF report from LoTW.
 // Optional 'since' date can be provided to filter (though LoTW API is a bit basic).
 // Actually LoTW allows query by qso_query=1 & qso_startdate=YYYY-MM-DD
-func (c *Client) FetchReport(since time.Time) (io.ReadCloser, error) {
+func (c *Client) Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error) {
 	// Construct URL params
 	// parameters documented/reversed engineered from standard usage
 	// login: user
@@ -64,7 +70,7 @@ func (c *Client) FetchReport(since time.Time) (io.ReadCloser, error) {
 
 	reqURL := fmt.Sprintf("%s?%s", c.BaseURL, v.Encode())
 
-	req, err := http.NewRequest("GET", reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
 	}
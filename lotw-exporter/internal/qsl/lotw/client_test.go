@@ -0,0 +1,58 @@
+package lotw
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetchSendsCredentialsAndStartDate(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		io.WriteString(w, `<call:5>K1ABC <eor>`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("K1ABC", "pass")
+	c.BaseURL = srv.URL
+
+	since := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	body, err := c.Fetch(context.Background(), since)
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer body.Close()
+
+	for _, want := range []string{"login=K1ABC", "password=pass", "qso_startdate=2026-03-04"} {
+		if !strings.Contains(gotQuery, want) {
+			t.Errorf("query %q missing %q", gotQuery, want)
+		}
+	}
+}
+
+func TestFetchDefaultsStartDateWhenSinceIsZero(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		io.WriteString(w, `<call:5>K1ABC <eor>`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("K1ABC", "pass")
+	c.BaseURL = srv.URL
+
+	body, err := c.Fetch(context.Background(), time.Time{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer body.Close()
+
+	if !strings.Contains(gotQuery, "qso_startdate=1900-01-01") {
+		t.Errorf("query %q missing default qso_startdate", gotQuery)
+	}
+}
@@ -0,0 +1,53 @@
+package qrz
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dbutler/lotw-exporter/internal/adif"
+)
+
+func TestFetchConvertsXMLToADIF(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<LOGBOOK>
+			<QSO>
+				<CALL>K1ABC</CALL>
+				<BAND>20M</BAND>
+				<MODE>FT8</MODE>
+				<QSO_DATE>20230101</QSO_DATE>
+				<TIME_ON>1200</TIME_ON>
+				<QSL_RCVD>Y</QSL_RCVD>
+			</QSO>
+		</LOGBOOK>`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key")
+	c.BaseURL = srv.URL
+
+	body, err := c.Fetch(context.Background(), time.Time{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer body.Close()
+
+	records, err := adif.Parse(body)
+	if err != nil {
+		t.Fatalf("parsing fetch result: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if got := records[0].Get("CALL"); got != "K1ABC" {
+		t.Errorf("CALL = %q, want K1ABC", got)
+	}
+	if got := records[0].Get("QSL_RCVD"); got != "Y" {
+		t.Errorf("QSL_RCVD = %q, want Y", got)
+	}
+	if got := records[0].Get("BAND"); got != "20M" {
+		t.Errorf("BAND = %q, want 20M", got)
+	}
+}
@@ -0,0 +1,22 @@
+// Package qsl abstracts over QSL confirmation services (LoTW, eQSL, QRZ
+// Logbook, ClubLog, ...) so the collector can compare confirmation rates
+// across services instead of being hard-wired to LoTW.
+package qsl
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Backend fetches ADIF data from a single QSL confirmation service.
+type Backend interface {
+	// Name identifies the backend, used as the "source" label on
+	// confirmation metrics (e.g. "lotw", "eqsl", "qrz", "clublog").
+	Name() string
+
+	// Fetch returns ADIF data reported since the given time. Backends that
+	// can't filter server-side may ignore it and return everything they
+	// have.
+	Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error)
+}
@@ -0,0 +1,123 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// File is a Source that reads one or more local ADIF files (or directories
+// of them, e.g. exports from N1MM, fldigi, WSJT-X, eQSL or ClubLog). Files
+// are only re-read when their mtime changes; otherwise the previous read is
+// reused.
+type File struct {
+	// Paths is a list of .adi/.adif files and/or directories to search for
+	// them in (non-recursive).
+	Paths []string
+
+	mu     sync.Mutex
+	mtimes map[string]time.Time
+	cached []byte
+}
+
+// NewFile returns a File source over the given paths.
+func NewFile(paths ...string) *File {
+	return &File{
+		Paths:  paths,
+		mtimes: make(map[string]time.Time),
+	}
+}
+
+func (s *File) Name() string { return "file" }
+
+// Fetch concatenates the content of every resolved ADIF file. The since
+// argument is ignored: filtering happens in the collector once records are
+// parsed, since ADIF files don't support server-side date filtering.
+func (s *File) Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	files, err := s.resolveFiles()
+	if err != nil {
+		return nil, fmt.Errorf("resolving adif files: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+	mtimes := make(map[string]time.Time, len(files))
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, fmt.Errorf("stat %s: %w", f, err)
+		}
+		mtimes[f] = info.ModTime()
+		if prev, ok := s.mtimes[f]; !ok || !prev.Equal(info.ModTime()) {
+			changed = true
+		}
+	}
+	if len(mtimes) != len(s.mtimes) {
+		changed = true
+	}
+
+	if !changed && s.cached != nil {
+		return io.NopCloser(bytes.NewReader(s.cached)), nil
+	}
+
+	var buf bytes.Buffer
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", f, err)
+		}
+		buf.Write(data)
+		buf.WriteString("\n")
+	}
+
+	s.mtimes = mtimes
+	s.cached = buf.Bytes()
+
+	return io.NopCloser(bytes.NewReader(s.cached)), nil
+}
+
+// resolveFiles expands Paths into a sorted, deduplicated list of .adi/.adif
+// files, descending one level into any directories.
+func (s *File) resolveFiles() ([]string, error) {
+	var files []string
+	for _, p := range s.Paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		entries, err := os.ReadDir(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.IsDir() || !isADIFFile(e.Name()) {
+				continue
+			}
+			files = append(files, filepath.Join(p, e.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func isADIFFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".adi" || ext == ".adif"
+}
@@ -0,0 +1,91 @@
+package source
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileFetchMergesMultipleFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	write := func(name, data string) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte(data), 0o644); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+		return p
+	}
+
+	a := write("a.adi", `<call:5>K1ABC <qso_date:8>20230101 <band:3>20M <mode:2>CW <eor>`)
+	b := write("b.adif", `<call:6>JA1XYZ <qso_date:8>20230102 <band:3>40M <mode:3>SSB <eor>`)
+
+	s := NewFile(a, b)
+
+	r, err := s.Fetch(context.Background(), time.Time{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("reading fetch result: %v", err)
+	}
+	r.Close()
+
+	if !strings.Contains(string(data), "K1ABC") || !strings.Contains(string(data), "JA1XYZ") {
+		t.Errorf("expected merged output to contain both records, got: %s", data)
+	}
+}
+
+func TestFileFetchCachesUntilMtimeChanges(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, "log.adi")
+	if err := os.WriteFile(p, []byte(`<call:5>K1ABC <eor>`), 0o644); err != nil {
+		t.Fatalf("writing file: %v", err)
+	}
+
+	s := NewFile(p)
+	ctx := context.Background()
+
+	r1, err := s.Fetch(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("first Fetch() error = %v", err)
+	}
+	first, _ := io.ReadAll(r1)
+	r1.Close()
+
+	// Re-fetch without touching the file: should reuse the cached read.
+	r2, err := s.Fetch(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("second Fetch() error = %v", err)
+	}
+	second, _ := io.ReadAll(r2)
+	r2.Close()
+	if string(first) != string(second) {
+		t.Errorf("expected cached content to be stable, got %q then %q", first, second)
+	}
+
+	// Touch the file with new content and a later mtime.
+	future := time.Now().Add(time.Minute)
+	if err := os.WriteFile(p, []byte(`<call:6>JA1XYZ <eor>`), 0o644); err != nil {
+		t.Fatalf("rewriting file: %v", err)
+	}
+	if err := os.Chtimes(p, future, future); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+
+	r3, err := s.Fetch(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("third Fetch() error = %v", err)
+	}
+	third, _ := io.ReadAll(r3)
+	r3.Close()
+	if !strings.Contains(string(third), "JA1XYZ") {
+		t.Errorf("expected re-read after mtime change to pick up new content, got: %s", third)
+	}
+}
+
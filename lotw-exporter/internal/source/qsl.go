@@ -0,0 +1,31 @@
+package source
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/dbutler/lotw-exporter/internal/qsl"
+)
+
+// backendSource adapts a qsl.Backend (LoTW, eQSL, QRZ, ClubLog, ...) to the
+// Source interface, so the collector can merge confirmation-service
+// fetches alongside local files and stdin without caring which backend
+// produced them.
+type backendSource struct {
+	backend qsl.Backend
+}
+
+// FromBackend wraps a qsl.Backend as a Source.
+func FromBackend(b qsl.Backend) Source {
+	return &backendSource{backend: b}
+}
+
+func (s *backendSource) Name() string { return s.backend.Name() }
+
+func (s *backendSource) Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.backend.Fetch(ctx, since)
+}
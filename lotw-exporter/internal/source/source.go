@@ -0,0 +1,32 @@
+// Package source abstracts where ADIF data comes from, so the collector
+// isn't hard-wired to fetching LoTW over HTTP. A Source produces a stream of
+// ADIF-formatted bytes; the collector is responsible for parsing and
+// aggregating whatever it returns.
+package source
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/dbutler/lotw-exporter/internal/adif"
+)
+
+// Source provides ADIF log data to the collector from some origin (LoTW,
+// local files, stdin, other QSL services, ...).
+type Source interface {
+	// Name identifies the source for logging purposes.
+	Name() string
+
+	// Fetch returns ADIF data produced since the given time. Sources that
+	// can't filter by time (e.g. a one-shot stdin read) may ignore it and
+	// return everything they have.
+	Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error)
+}
+
+// RecordKey returns the unique key used to identify a QSO across sources, so
+// records fetched from different places can be merged rather than
+// duplicated.
+func RecordKey(rec adif.Record) string {
+	return rec.Get("CALL") + "|" + rec.Get("QSO_DATE") + "|" + rec.Get("TIME_ON") + "|" + rec.Get("BAND") + "|" + rec.Get("MODE")
+}
@@ -0,0 +1,41 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Stdin is a one-shot Source that reads ADIF data from standard input. It
+// can only be fetched once; subsequent calls return io.EOF.
+type Stdin struct {
+	r    io.Reader
+	used bool
+}
+
+// NewStdin returns a Source that reads from os.Stdin.
+func NewStdin() *Stdin {
+	return &Stdin{r: os.Stdin}
+}
+
+func (s *Stdin) Name() string { return "stdin" }
+
+// Fetch reads all of stdin and returns it. since is ignored, since stdin
+// input is a one-shot dump with no fetch history.
+func (s *Stdin) Fetch(ctx context.Context, since time.Time) (io.ReadCloser, error) {
+	if s.used {
+		return nil, io.EOF
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(s.r)
+	if err != nil {
+		return nil, fmt.Errorf("reading stdin: %w", err)
+	}
+	s.used = true
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
@@ -0,0 +1,157 @@
+// Package store persists parsed ADIF records to disk between runs, so the
+// collector doesn't need to refetch a user's entire LoTW history (or other
+// source) on every scrape.
+package store
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dbutler/lotw-exporter/internal/adif"
+	"github.com/dbutler/lotw-exporter/internal/source"
+)
+
+// state is the gob-encoded on-disk payload.
+type state struct {
+	Records   map[string]adif.Record
+	LastFetch time.Time
+}
+
+// Store is a corruption-safe, gob-backed cache of merged QSO records keyed
+// by source.RecordKey.
+type Store struct {
+	path string
+
+	mu    sync.Mutex
+	state state
+}
+
+// Open loads the store from path if it exists, or returns an empty store
+// ready to be populated and saved. A missing file is not an error: it just
+// means this is the first run.
+func Open(path string) (*Store, error) {
+	s := &Store{
+		path:  path,
+		state: state{Records: make(map[string]adif.Record)},
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("reading store: %w", err)
+	}
+
+	var st state
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&st); err != nil {
+		return nil, fmt.Errorf("decoding store: %w", err)
+	}
+	if st.Records == nil {
+		st.Records = make(map[string]adif.Record)
+	}
+	s.state = st
+	return s, nil
+}
+
+// LastFetch returns the timestamp passed to the most recent Merge call, or
+// the zero Time if the store has never been merged into.
+func (s *Store) LastFetch() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.LastFetch
+}
+
+// Merge folds newly fetched records into the store. Records sharing a key
+// with one already in the store have their fields overwritten by the new
+// values, so re-confirmations (QSL_RCVD flipping to Y, QSLRDATE appearing)
+// update the stored record in place rather than being treated as new QSOs.
+// QSL_RCVD is never downgraded once it's Y: a source like LoTW re-reports
+// every QSO in its window regardless of confirmation status, so a later
+// cycle seeing that QSO as unconfirmed must not erase a confirmation
+// recorded by another backend in an earlier cycle. fetchedAt becomes the
+// new LastFetch if it is more recent.
+func (s *Store) Merge(records []adif.Record, fetchedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, rec := range records {
+		key := source.RecordKey(rec)
+		existing, ok := s.state.Records[key]
+		if !ok {
+			s.state.Records[key] = rec
+			continue
+		}
+		for name, value := range rec.Values {
+			if name == "QSL_RCVD" && strings.ToUpper(existing.Values[name]) == "Y" && strings.ToUpper(value) != "Y" {
+				continue
+			}
+			existing.Set(name, value, rec.Types[name])
+		}
+		s.state.Records[key] = existing
+	}
+
+	if fetchedAt.After(s.state.LastFetch) {
+		s.state.LastFetch = fetchedAt
+	}
+}
+
+// Records returns a snapshot of every record currently held by the store.
+func (s *Store) Records() []adif.Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]adif.Record, 0, len(s.state.Records))
+	for _, rec := range s.state.Records {
+		out = append(out, rec)
+	}
+	return out
+}
+
+// Reset clears the store's contents and last-fetch timestamp, forcing the
+// next Merge to rebuild state from scratch (used by --refresh-full).
+func (s *Store) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state{Records: make(map[string]adif.Record)}
+}
+
+// Save writes the store to disk atomically: the new content is written to a
+// temp file in the same directory and renamed into place, so a crash or
+// power loss mid-write can't corrupt the existing file.
+func (s *Store) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.state); err != nil {
+		return fmt.Errorf("encoding store: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once renamed into place
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing temp file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("renaming temp file into place: %w", err)
+	}
+	return nil
+}
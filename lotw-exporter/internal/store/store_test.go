@@ -0,0 +1,102 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dbutler/lotw-exporter/internal/adif"
+)
+
+func newRecord(fields map[string]string) adif.Record {
+	rec := adif.NewRecord()
+	for name, value := range fields {
+		rec.Set(name, value, 0)
+	}
+	return rec
+}
+
+func TestMergeUpdatesReconfirmedRecord(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "state.gob"))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Merge([]adif.Record{newRecord(map[string]string{
+		"CALL": "K1ABC", "QSO_DATE": "20260101", "TIME_ON": "1200", "BAND": "20M", "MODE": "FT8",
+	})}, t1)
+
+	t2 := t1.Add(24 * time.Hour)
+	s.Merge([]adif.Record{newRecord(map[string]string{
+		"CALL": "K1ABC", "QSO_DATE": "20260101", "TIME_ON": "1200", "BAND": "20M", "MODE": "FT8",
+		"QSL_RCVD": "Y", "QSLRDATE": "20260102",
+	})}, t2)
+
+	records := s.Records()
+	if len(records) != 1 {
+		t.Fatalf("expected 1 merged record, got %d", len(records))
+	}
+	if records[0].Get("QSL_RCVD") != "Y" {
+		t.Errorf("expected re-confirmation to update QSL_RCVD, got %v", records[0].Get("QSL_RCVD"))
+	}
+	if !s.LastFetch().Equal(t2) {
+		t.Errorf("expected LastFetch to advance to %v, got %v", t2, s.LastFetch())
+	}
+}
+
+func TestMergeDoesNotRegressConfirmedQSLAcrossCycles(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "state.gob"))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Merge([]adif.Record{newRecord(map[string]string{
+		"CALL": "K1ABC", "QSO_DATE": "20260101", "TIME_ON": "1200", "BAND": "20M", "MODE": "FT8",
+		"QSL_RCVD": "Y", "QSLRDATE": "20260102",
+	})}, t1)
+
+	// A later cycle re-reports the same QSO as unconfirmed (e.g. LoTW,
+	// which sends every QSO in its window regardless of QSL status). The
+	// confirmation recorded by another backend must survive.
+	t2 := t1.Add(24 * time.Hour)
+	s.Merge([]adif.Record{newRecord(map[string]string{
+		"CALL": "K1ABC", "QSO_DATE": "20260101", "TIME_ON": "1200", "BAND": "20M", "MODE": "FT8",
+		"QSL_RCVD": "N",
+	})}, t2)
+
+	records := s.Records()
+	if len(records) != 1 {
+		t.Fatalf("expected 1 merged record, got %d", len(records))
+	}
+	if got := records[0].Get("QSL_RCVD"); got != "Y" {
+		t.Errorf("expected confirmation to survive a later unconfirmed re-report, got QSL_RCVD=%v", got)
+	}
+	if got := records[0].Get("QSLRDATE"); got != "20260102" {
+		t.Errorf("expected QSLRDATE to be preserved, got %v", got)
+	}
+}
+
+func TestSaveAndReopenRoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.gob")
+
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	s.Merge([]adif.Record{newRecord(map[string]string{
+		"CALL": "K1ABC", "QSO_DATE": "20260101", "TIME_ON": "1200", "BAND": "20M", "MODE": "FT8",
+	})}, time.Now())
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("re-Open() error = %v", err)
+	}
+	if len(reopened.Records()) != 1 {
+		t.Errorf("expected 1 record after reopening, got %d", len(reopened.Records()))
+	}
+}